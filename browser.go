@@ -0,0 +1,126 @@
+package main
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/manifoldco/promptui"
+)
+
+// maxDiscoveryPages caps how many DescribeParameters pages are scanned when
+// discovering namespaces.
+const maxDiscoveryPages = 10
+
+type browserLevel struct {
+	folders []string
+	leaves  []*ssm.Parameter
+}
+
+// computeBrowserLevel splits params into the folders and leaves visible at root+path.
+func computeBrowserLevel(params []*ssm.Parameter, root, path string) browserLevel {
+	base := root + path
+
+	var level browserLevel
+	seenFolders := make(map[string]bool)
+
+	for _, param := range params {
+		if !strings.HasPrefix(*param.Name, base) {
+			continue
+		}
+
+		rest := strings.TrimPrefix(*param.Name, base)
+		segments := strings.SplitN(rest, "/", 2)
+
+		if len(segments) == 2 {
+			folder := segments[0]
+			if !seenFolders[folder] {
+				seenFolders[folder] = true
+				level.folders = append(level.folders, folder)
+			}
+			continue
+		}
+
+		level.leaves = append(level.leaves, param)
+	}
+
+	sort.Strings(level.folders)
+	sort.Slice(level.leaves, func(i, j int) bool {
+		return *level.leaves[i].Name < *level.leaves[j].Name
+	})
+
+	return level
+}
+
+// discoverNamespaces lists top-level parameter namespaces across the account.
+func discoverNamespaces(svc *ssm.SSM) ([]string, error) {
+	namespaces := make(map[string]struct{})
+	var nextToken *string
+	pages := 0
+
+	for {
+		result, err := svc.DescribeParameters(&ssm.DescribeParametersInput{
+			MaxResults: aws.Int64(50),
+			NextToken:  nextToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range result.Parameters {
+			segments := strings.SplitN(strings.TrimPrefix(*p.Name, "/"), "/", 2)
+			if segments[0] != "" {
+				namespaces["/"+segments[0]+"/"] = struct{}{}
+			}
+		}
+
+		pages++
+		if result.NextToken == nil || pages >= maxDiscoveryPages {
+			break
+		}
+		nextToken = result.NextToken
+	}
+
+	list := make([]string, 0, len(namespaces))
+	for ns := range namespaces {
+		list = append(list, ns)
+	}
+	sort.Strings(list)
+
+	return list, nil
+}
+
+// promptForPrefix lets the user pick a discovered namespace or type a prefix by hand.
+func promptForPrefix(svc *ssm.SSM) (string, error) {
+	const customEntry = "Enter custom prefix..."
+
+	namespaces, err := discoverNamespaces(svc)
+	if err != nil || len(namespaces) == 0 {
+		prompt := promptui.Prompt{Label: "Enter SSM parameter prefix"}
+		return prompt.Run()
+	}
+
+	selectPrompt := promptui.Select{
+		Label: "Select a namespace to browse",
+		Items: append(append([]string{}, namespaces...), customEntry),
+		Size:  20,
+	}
+
+	_, result, err := selectPrompt.Run()
+	if err != nil {
+		return "", err
+	}
+
+	if result == customEntry {
+		prompt := promptui.Prompt{Label: "Enter SSM parameter prefix"}
+		return prompt.Run()
+	}
+
+	return result, nil
+}
+
+// breadcrumb renders the current browsing location, e.g. "/prod/api/sub/".
+func breadcrumb(root, path string) string {
+	return promptui.Styler(promptui.FGBold)(root + path)
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/manifoldco/promptui"
+)
+
+func deleteParameter(svc *ssm.SSM, name string) error {
+	_, err := svc.DeleteParameter(&ssm.DeleteParameterInput{
+		Name: aws.String(name),
+	})
+	return err
+}
+
+// renameParameter implements rename as a copy to the new name followed by a
+// delete of the old one, since SSM has no native rename operation. The
+// *ssm.Parameter in hand (from GetParametersByPath) carries no KeyId, so a
+// SecureString's KMS key is looked up separately to avoid silently
+// re-encrypting it under the default alias/aws/ssm key.
+func renameParameter(svc *ssm.SSM, param *ssm.Parameter, newName string) error {
+	input := &ssm.PutParameterInput{
+		Name:      aws.String(newName),
+		Value:     param.Value,
+		Type:      param.Type,
+		Overwrite: aws.Bool(false),
+	}
+
+	if *param.Type == "SecureString" {
+		meta, err := fetchSingleParameterMetadata(svc, *param.Name)
+		if err != nil {
+			return fmt.Errorf("failed to look up KMS key for %s: %v", *param.Name, err)
+		}
+		if meta != nil && meta.KeyId != nil {
+			input.KeyId = meta.KeyId
+		}
+	}
+
+	_, err := svc.PutParameter(input)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %v", newName, err)
+	}
+
+	if err := deleteParameter(svc, *param.Name); err != nil {
+		return fmt.Errorf("created %s but failed to delete %s: %v", newName, *param.Name, err)
+	}
+
+	return nil
+}
+
+// confirmAction shows a yes/no prompt and reports whether the user
+// confirmed. Declining (ctrl-c or 'n') is not an error.
+func confirmAction(label string) (bool, error) {
+	prompt := promptui.Prompt{
+		Label:     label,
+		IsConfirm: true,
+	}
+
+	_, err := prompt.Run()
+	if err != nil {
+		if err == promptui.ErrAbort || err == promptui.ErrInterrupt {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// deleteAllUnderPrefix deletes every parameter under prefix after requiring
+// the user to type the prefix back as a guard against fat-fingered batch
+// deletes.
+func deleteAllUnderPrefix(svc *ssm.SSM, prefix string) (int, error) {
+	params, err := fetchParameters(svc, prefix, true)
+	if err != nil {
+		return 0, err
+	}
+	if len(params) == 0 {
+		return 0, nil
+	}
+
+	prompt := promptui.Prompt{
+		Label: fmt.Sprintf("Type %q to permanently delete %d parameter(s) under this prefix", prefix, len(params)),
+	}
+
+	typed, err := prompt.Run()
+	if err != nil {
+		return 0, err
+	}
+	if typed != prefix {
+		return 0, fmt.Errorf("confirmation did not match %q, aborting", prefix)
+	}
+
+	for i, param := range params {
+		if err := deleteParameter(svc, *param.Name); err != nil {
+			return i, fmt.Errorf("failed to delete %s: %v", *param.Name, err)
+		}
+	}
+
+	return len(params), nil
+}
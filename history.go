@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/manifoldco/promptui"
+)
+
+func fetchParameterHistory(svc *ssm.SSM, name string) ([]*ssm.ParameterHistory, error) {
+	var history []*ssm.ParameterHistory
+	var nextToken *string
+
+	for {
+		result, err := svc.GetParameterHistory(&ssm.GetParameterHistoryInput{
+			Name:           aws.String(name),
+			WithDecryption: aws.Bool(true),
+			NextToken:      nextToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		history = append(history, result.Parameters...)
+
+		if result.NextToken == nil {
+			break
+		}
+		nextToken = result.NextToken
+	}
+
+	return history, nil
+}
+
+func formatHistoryEntries(history []*ssm.ParameterHistory, secure bool) []string {
+	var formatted []string
+	for _, entry := range history {
+		value := "****"
+		if !(secure && entry.Type != nil && *entry.Type == "SecureString") {
+			value = *entry.Value
+		}
+
+		user := "-"
+		if entry.LastModifiedUser != nil {
+			user = *entry.LastModifiedUser
+		}
+
+		modified := "-"
+		if entry.LastModifiedDate != nil {
+			modified = entry.LastModifiedDate.Format(time.RFC3339)
+		}
+
+		formatted = append(formatted, fmt.Sprintf("v%d | %s | %s | %s", *entry.Version, modified, user, value))
+	}
+	return formatted
+}
+
+// browseParameterHistory shows every recorded version of name and, if the
+// user picks one, rolls back to it via PutParameter. It returns the rolled
+// back parameter so the caller can update latestParam, or nil if the user
+// backed out without rolling back.
+func browseParameterHistory(svc *ssm.SSM, name string, secure bool) (*ssm.Parameter, error) {
+	history, err := fetchParameterHistory(svc, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch history: %v", err)
+	}
+	if len(history) == 0 {
+		fmt.Println("No history found.")
+		return nil, nil
+	}
+
+	sort.Slice(history, func(i, j int) bool {
+		return *history[i].Version > *history[j].Version
+	})
+
+	prompt := promptui.Select{
+		Label: fmt.Sprintf("History for %s", name),
+		Items: formatHistoryEntries(history, secure),
+		Size:  20,
+	}
+
+	index, _, err := prompt.Run()
+	if err != nil {
+		if err == promptui.ErrInterrupt {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("prompt failed: %v", err)
+	}
+
+	entry := history[index]
+
+	confirmed, err := confirmAction(fmt.Sprintf("Rollback %s to version %d", name, *entry.Version))
+	if err != nil {
+		return nil, fmt.Errorf("prompt failed: %v", err)
+	}
+	if !confirmed {
+		return nil, nil
+	}
+
+	input := &ssm.PutParameterInput{
+		Name:      aws.String(name),
+		Value:     entry.Value,
+		Type:      entry.Type,
+		Overwrite: aws.Bool(true),
+	}
+	if entry.Type != nil && *entry.Type == "SecureString" {
+		input.KeyId = entry.KeyId
+	}
+
+	_, err = svc.PutParameter(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to roll back to version %d: %v", *entry.Version, err)
+	}
+
+	return &ssm.Parameter{
+		Name:  aws.String(name),
+		Value: entry.Value,
+		Type:  entry.Type,
+	}, nil
+}
@@ -3,7 +3,7 @@ package main
 import (
 	"flag"
 	"fmt"
-	"sort"
+	"os"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -14,28 +14,54 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "push", "pull":
+			sess := session.Must(session.NewSessionWithOptions(session.Options{
+				SharedConfigState: session.SharedConfigEnable,
+			}))
+			svc := ssm.New(sess)
+
+			var err error
+			if os.Args[1] == "push" {
+				err = runPush(svc, os.Args[2:])
+			} else {
+				err = runPull(svc, os.Args[2:])
+			}
+			if err != nil {
+				fmt.Printf("Error: %v\n", err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
 	prefixFlag := flag.String("prefix", "", "SSM parameter prefix")
 	debugFlag := flag.Bool("debug", false, "Run in debug mode with additional output")
 	secureFlag := flag.Bool("secure", false, "Run in secure mode with masked input, and hidden secret strings")
+	profileFlag := flag.String("profile", "", "AWS shared config profile to use")
+	regionFlag := flag.String("region", "", "AWS region to use")
 
 	flag.Parse()
 
-	sess := session.Must(session.NewSessionWithOptions(session.Options{
-		SharedConfigState: session.SharedConfigEnable,
-	}))
+	currentProfile := *profileFlag
+	currentRegion := *regionFlag
+
+	sess, err := newAWSSession(currentProfile, currentRegion)
+	if err != nil {
+		fmt.Printf("Error creating AWS session: %v\n", err)
+		return
+	}
 
 	svc := ssm.New(sess)
+	accountID := fetchAccountID(sess)
 
 	var prefix string
 	if *prefixFlag != "" {
 		prefix = *prefixFlag
 	} else {
-		prompt := promptui.Prompt{
-			Label: "Enter SSM parameter prefix",
-		}
-
 		var err error
-		prefix, err = prompt.Run()
+		prefix, err = promptForPrefix(svc)
 		if err != nil {
 			fmt.Printf("Prompt failed %v\n", err)
 			return
@@ -48,10 +74,12 @@ func main() {
 	}
 
 	var latestParam *ssm.Parameter
+	var currentPath string
 
 	for {
-		// Fetch parameters
-		params, err := fetchParameters(svc, prefix)
+		// Fetch parameters recursively so the browser can walk sub-paths
+		// from one listing; the listing is refetched on every pass of the loop.
+		allParams, err := fetchParameters(svc, prefix, true)
 		if err != nil {
 			fmt.Printf("Error fetching parameters: %v\n", err)
 			return
@@ -59,24 +87,50 @@ func main() {
 
 		// Update parameters with the latest param if it exists
 		if latestParam != nil {
-			for i, param := range params {
+			for i, param := range allParams {
 				if *param.Name == *latestParam.Name {
-					params[i] = latestParam
+					allParams[i] = latestParam
 					break
 				}
 			}
 		}
 
-		// Sort parameters alphabetically
-		sort.Slice(params, func(i, j int) bool {
-			return *params[i].Name < *params[j].Name
-		})
+		level := computeBrowserLevel(allParams, prefix, currentPath)
 
-		items := append([]string{"Create new variable"}, formatParameters(params, *secureFlag)...)
+		hasUp := currentPath != ""
+		items := []string{}
+		if hasUp {
+			items = append(items, "..")
+		}
+		createIndex := len(items)
+		items = append(items, "Create new variable")
+		deleteAllIndex := len(items)
+		items = append(items, "Delete all parameters under this prefix")
+		switchSessionIndex := len(items)
+		items = append(items, "Switch profile/region")
+		folderStartIndex := len(items)
+		for _, folder := range level.folders {
+			items = append(items, folder+"/")
+		}
+		leafStartIndex := len(items)
+		leafLines := formatParameters(level.leaves, *secureFlag)
+		if *debugFlag {
+			if metadata, err := fetchParameterMetadata(svc, prefix); err == nil {
+				for i, p := range level.leaves {
+					leafLines[i] += formatParameterMetaSuffix(metadata[*p.Name])
+				}
+			}
+		}
+		items = append(items, leafLines...)
 
 		// Display parameters
 		prompt := promptui.Select{
-			Label:        promptui.Styler(promptui.FGFaint)("↑/↓: navigate • enter: select • /:search • ctrl+c: quit"),
+			Label: fmt.Sprintf(
+				"%s\n%s\n%s",
+				promptui.Styler(promptui.FGFaint)(sessionHeader(sess, accountID, currentProfile, currentRegion, prefix+currentPath)),
+				breadcrumb(prefix, currentPath),
+				promptui.Styler(promptui.FGFaint)("↑/↓: navigate • enter: select • /:search • ctrl+c: quit"),
+			),
 			Items:        items,
 			Size:         20,
 			HideSelected: !*debugFlag,
@@ -87,24 +141,27 @@ func main() {
 				Selected: "▶ {{ . | underline }}",
 			},
 			HideHelp:  true,
-			CursorPos: 1,
+			CursorPos: folderStartIndex,
 			Searcher: func(input string, index int) bool {
 				item := items[index]
-				if index == 0 {
-					// Special case for "Create new variable"
+				if index == createIndex || index == deleteAllIndex || index == switchSessionIndex || (hasUp && index == 0) {
 					return strings.Contains(strings.ToLower(item), strings.ToLower(input))
 				}
-				// For actual parameters, search only in the key name
+				// For folders and actual parameters, search only in the key name
 				keyName := strings.SplitN(item, " = ", 2)[0]
+				keyName = strings.TrimSuffix(keyName, "/")
 				return strings.Contains(strings.ToLower(keyName), strings.ToLower(input))
 			},
 		}
 
 		funcMap := promptui.FuncMap
 		funcMap["greyOrNormal"] = func(s string) string {
-			if s == "Create new variable" {
+			if s == "Create new variable" || s == ".." {
 				return promptui.Styler(promptui.FGBold)(s)
 			}
+			if strings.HasSuffix(s, "/") {
+				return promptui.Styler(promptui.FGCyan)(s)
+			}
 
 			return promptui.Styler(promptui.FGYellow)(s)
 		}
@@ -125,20 +182,148 @@ func main() {
 			return
 		}
 
-		if index == 0 {
+		if hasUp && index == 0 {
+			// Go up one level
+			segments := strings.Split(strings.TrimSuffix(currentPath, "/"), "/")
+			segments = segments[:len(segments)-1]
+			currentPath = ""
+			if len(segments) > 0 && segments[0] != "" {
+				currentPath = strings.Join(segments, "/") + "/"
+			}
+			continue
+		}
+
+		if index == createIndex {
 			// Create new variable
-			err = createNewParameter(svc, prefix, *debugFlag)
+			err = createNewParameter(svc, prefix+currentPath, *debugFlag)
 			if err != nil {
 				fmt.Printf("Error creating parameter: %v\n", err)
 			}
 			continue
 		}
 
+		if index == deleteAllIndex {
+			deleted, err := deleteAllUnderPrefix(svc, prefix+currentPath)
+			if err != nil {
+				fmt.Printf("Error deleting parameters: %v\n", err)
+			} else if *debugFlag {
+				fmt.Printf("Deleted %d parameter(s).\n", deleted)
+			}
+			continue
+		}
+
+		if index == switchSessionIndex {
+			newSess, newProfile, newRegion, err := switchSession(currentProfile, currentRegion)
+			if err != nil {
+				fmt.Printf("Error switching session: %v\n", err)
+			} else if newSess != nil {
+				sess = newSess
+				svc = ssm.New(sess)
+				accountID = fetchAccountID(sess)
+				currentProfile = newProfile
+				currentRegion = newRegion
+				latestParam = nil
+			}
+			continue
+		}
+
+		if index >= folderStartIndex && index < leafStartIndex {
+			// Descend into a folder
+			currentPath += level.folders[index-folderStartIndex] + "/"
+			continue
+		}
+
+		param := level.leaves[index-leafStartIndex]
+
 		// Extract parameter name from result
 		paramName := strings.SplitN(result, " = ", 2)[0]
-		paramName = prefix + paramName
+		paramName = prefix + currentPath + paramName
+
+		actionPrompt := promptui.Select{
+			Label: paramName,
+			Items: []string{"Edit value", "Rename parameter", "Delete parameter", "Change type/tier/KMS key", "Manage tags", "History", "Back"},
+		}
+
+		_, action, err := actionPrompt.Run()
+		if err != nil {
+			if err == promptui.ErrInterrupt {
+				continue
+			}
+			fmt.Printf("Prompt failed %v\n", err)
+			return
+		}
+
+		if action == "Back" {
+			continue
+		}
 
-		currentValue := *params[index-1].Value
+		if action == "Delete parameter" {
+			confirmed, err := confirmAction(fmt.Sprintf("Delete %s", paramName))
+			if err != nil {
+				fmt.Printf("Prompt failed %v\n", err)
+				continue
+			}
+			if !confirmed {
+				continue
+			}
+			if err := deleteParameter(svc, paramName); err != nil {
+				fmt.Printf("Error deleting parameter: %v\n", err)
+			} else if *debugFlag {
+				fmt.Println("Parameter deleted successfully.")
+			}
+			continue
+		}
+
+		if action == "Change type/tier/KMS key" {
+			updated, err := changeParameterSettings(svc, param)
+			if err != nil {
+				fmt.Printf("Error updating parameter settings: %v\n", err)
+			} else {
+				latestParam = updated
+				if *debugFlag {
+					fmt.Println("Parameter settings updated successfully.")
+				}
+			}
+			continue
+		}
+
+		if action == "History" {
+			rolledBack, err := browseParameterHistory(svc, paramName, *secureFlag)
+			if err != nil {
+				fmt.Printf("Error browsing history: %v\n", err)
+			} else if rolledBack != nil {
+				latestParam = rolledBack
+				if *debugFlag {
+					fmt.Println("Parameter rolled back successfully.")
+				}
+			}
+			continue
+		}
+
+		if action == "Manage tags" {
+			if err := manageTags(svc, paramName); err != nil {
+				fmt.Printf("Error managing tags: %v\n", err)
+			}
+			continue
+		}
+
+		if action == "Rename parameter" {
+			namePrompt := promptui.Prompt{Label: "Enter new name (relative to current path)"}
+			newLeaf, err := namePrompt.Run()
+			if err != nil {
+				fmt.Printf("Prompt failed %v\n", err)
+				continue
+			}
+			newName := prefix + currentPath + newLeaf
+			if err := renameParameter(svc, param, newName); err != nil {
+				fmt.Printf("Error renaming parameter: %v\n", err)
+			} else if *debugFlag {
+				fmt.Println("Parameter renamed successfully.")
+			}
+			continue
+		}
+
+		currentValue := *param.Value
 		if *secureFlag {
 			currentValue = ""
 		}
@@ -188,7 +373,7 @@ func main() {
 		}
 
 		// Update parameter
-		err = updateParameter(svc, paramName, newValue, *params[index-1].Type)
+		err = updateParameter(svc, paramName, newValue, *param.Type)
 		if err != nil {
 			fmt.Printf("Error updating parameter: %v\n", err)
 		} else {
@@ -199,20 +384,20 @@ func main() {
 			latestParam = &ssm.Parameter{
 				Name:  aws.String(paramName),
 				Value: aws.String(newValue),
-				Type:  params[index-1].Type,
+				Type:  param.Type,
 			}
 		}
 	}
 }
 
-func fetchParameters(svc *ssm.SSM, prefix string) ([]*ssm.Parameter, error) {
+func fetchParameters(svc *ssm.SSM, prefix string, recursive bool) ([]*ssm.Parameter, error) {
 	var parameters []*ssm.Parameter
 	var nextToken *string
 
 	for {
 		input := &ssm.GetParametersByPathInput{
 			Path:           aws.String(prefix),
-			Recursive:      aws.Bool(false),
+			Recursive:      aws.Bool(recursive),
 			WithDecryption: aws.Bool(true),
 			NextToken:      nextToken,
 		}
@@ -285,6 +470,11 @@ func createNewParameter(svc *ssm.SSM, prefix string, quiet bool) error {
 		return fmt.Errorf("type prompt failed: %v", err)
 	}
 
+	tags, err := promptForInitialTags()
+	if err != nil {
+		return fmt.Errorf("tags prompt failed: %v", err)
+	}
+
 	fullName := prefix + name
 
 	_, err = svc.PutParameter(&ssm.PutParameterInput{
@@ -292,6 +482,7 @@ func createNewParameter(svc *ssm.SSM, prefix string, quiet bool) error {
 		Value:     aws.String(value),
 		Type:      aws.String(paramType),
 		Overwrite: aws.Bool(false),
+		Tags:      tags,
 	})
 
 	if err != nil {
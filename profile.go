@@ -0,0 +1,150 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/manifoldco/promptui"
+	ini "gopkg.in/ini.v1"
+)
+
+// newAWSSession builds a session for the given profile/region, falling back
+// to the ambient shared config when either is empty.
+func newAWSSession(profile, region string) (*session.Session, error) {
+	opts := session.Options{
+		SharedConfigState: session.SharedConfigEnable,
+	}
+	if profile != "" {
+		opts.Profile = profile
+	}
+	if region != "" {
+		opts.Config = aws.Config{Region: aws.String(region)}
+	}
+
+	return session.NewSessionWithOptions(opts)
+}
+
+// listAWSProfiles reads profile names out of the shared ~/.aws/config and
+// ~/.aws/credentials files.
+func listAWSProfiles() ([]string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := make(map[string]struct{})
+
+	if cfg, err := ini.Load(filepath.Join(home, ".aws", "config")); err == nil {
+		for _, section := range cfg.Sections() {
+			name := strings.TrimPrefix(section.Name(), "profile ")
+			if name != "" && name != "DEFAULT" {
+				profiles[name] = struct{}{}
+			}
+		}
+	}
+
+	if creds, err := ini.Load(filepath.Join(home, ".aws", "credentials")); err == nil {
+		for _, section := range creds.Sections() {
+			name := section.Name()
+			if name != "" && name != "DEFAULT" {
+				profiles[name] = struct{}{}
+			}
+		}
+	}
+
+	list := make([]string, 0, len(profiles))
+	for name := range profiles {
+		list = append(list, name)
+	}
+	sort.Strings(list)
+
+	return list, nil
+}
+
+// switchSession prompts the user to change profile or region and rebuilds
+// the AWS session accordingly.
+func switchSession(currentProfile, currentRegion string) (*session.Session, string, string, error) {
+	prompt := promptui.Select{
+		Label: "Switch profile or region",
+		Items: []string{"Switch profile", "Switch region", "Cancel"},
+	}
+
+	_, choice, err := prompt.Run()
+	if err != nil {
+		if err == promptui.ErrInterrupt {
+			return nil, "", "", nil
+		}
+		return nil, "", "", fmt.Errorf("prompt failed: %v", err)
+	}
+
+	newProfile := currentProfile
+	newRegion := currentRegion
+
+	switch choice {
+	case "Cancel":
+		return nil, "", "", nil
+	case "Switch profile":
+		profiles, err := listAWSProfiles()
+		if err != nil || len(profiles) == 0 {
+			namePrompt := promptui.Prompt{Label: "Enter AWS profile name"}
+			newProfile, err = namePrompt.Run()
+			if err != nil {
+				return nil, "", "", fmt.Errorf("profile prompt failed: %v", err)
+			}
+		} else {
+			selectPrompt := promptui.Select{Label: "Select AWS profile", Items: profiles, Size: 20}
+			_, newProfile, err = selectPrompt.Run()
+			if err != nil {
+				return nil, "", "", fmt.Errorf("profile prompt failed: %v", err)
+			}
+		}
+	case "Switch region":
+		regionPrompt := promptui.Prompt{Label: "Enter AWS region"}
+		newRegion, err = regionPrompt.Run()
+		if err != nil {
+			return nil, "", "", fmt.Errorf("region prompt failed: %v", err)
+		}
+	}
+
+	if newProfile == currentProfile && newRegion == currentRegion {
+		return nil, "", "", nil
+	}
+
+	sess, err := newAWSSession(newProfile, newRegion)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("failed to build session: %v", err)
+	}
+
+	return sess, newProfile, newRegion, nil
+}
+
+// fetchAccountID resolves the AWS account id for sess via STS. Callers should
+// cache the result rather than call this on every redraw.
+func fetchAccountID(sess *session.Session) string {
+	identity, err := sts.New(sess).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil || identity.Account == nil {
+		return "unknown"
+	}
+	return *identity.Account
+}
+
+// sessionHeader renders the "account-id | region | profile | prefix" status line.
+func sessionHeader(sess *session.Session, account, profile, region, prefix string) string {
+	if region == "" {
+		region = aws.StringValue(sess.Config.Region)
+	}
+	if region == "" {
+		region = "-"
+	}
+	if profile == "" {
+		profile = "default"
+	}
+
+	return fmt.Sprintf("%s | %s | %s | %s", account, region, profile, prefix)
+}
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"gopkg.in/yaml.v3"
+)
+
+// runPush implements the `push` subcommand: read a JSON/YAML/dotenv file and
+// PutParameter every key under prefix.
+func runPush(svc *ssm.SSM, args []string) error {
+	fs := flag.NewFlagSet("push", flag.ExitOnError)
+	file := fs.String("file", "", "Path to JSON/YAML/dotenv file to push")
+	prefix := fs.String("prefix", "", "SSM parameter prefix")
+	format := fs.String("format", "", "Input format: json, yaml, or dotenv (default: inferred from file extension)")
+	secure := fs.Bool("secure", false, "Store pushed parameters as SecureString unless a key's own \"secure\" setting overrides it")
+	dryRun := fs.Bool("dry-run", false, "Print what would change without writing to SSM")
+	diff := fs.Bool("diff", false, "Show current vs. new values before applying")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *file == "" || *prefix == "" {
+		return fmt.Errorf("--file and --prefix are required")
+	}
+	prefix = aws.String(ensureTrailingSlash(*prefix))
+
+	kv, err := loadKeyValues(*file, resolveFormat(*format, *file))
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %v", *file, err)
+	}
+
+	keys := make([]string, 0, len(kv))
+	for k := range kv {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	current, err := fetchParameters(svc, *prefix, false)
+	if err != nil {
+		return fmt.Errorf("failed to fetch existing parameters: %v", err)
+	}
+	currentByName := make(map[string]*ssm.Parameter, len(current))
+	for _, p := range current {
+		currentByName[*p.Name] = p
+	}
+
+	for _, key := range keys {
+		name := *prefix + key
+		entry := kv[key]
+		newValue := entry.Value
+
+		paramType := "String"
+		if *secure {
+			paramType = "SecureString"
+		}
+		if entry.Secure != nil {
+			if *entry.Secure {
+				paramType = "SecureString"
+			} else {
+				paramType = "String"
+			}
+		}
+
+		if *diff || *dryRun {
+			old := "<new>"
+			if existing, ok := currentByName[name]; ok {
+				old = *existing.Value
+				if *existing.Type == "SecureString" {
+					old = "******"
+				}
+			}
+			shown := newValue
+			if paramType == "SecureString" {
+				shown = "******"
+			}
+			fmt.Printf("%s:\n  - %s\n  + %s\n", name, old, shown)
+		}
+
+		if *dryRun {
+			continue
+		}
+
+		_, err := svc.PutParameter(&ssm.PutParameterInput{
+			Name:      aws.String(name),
+			Value:     aws.String(newValue),
+			Type:      aws.String(paramType),
+			Overwrite: aws.Bool(true),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to put %s: %v", name, err)
+		}
+	}
+
+	return nil
+}
+
+// runPull implements the `pull` subcommand: dump every parameter directly
+// under prefix to stdout, keyed by its last path segment. Parameters in
+// sub-paths of prefix are not included.
+func runPull(svc *ssm.SSM, args []string) error {
+	fs := flag.NewFlagSet("pull", flag.ExitOnError)
+	prefix := fs.String("prefix", "", "SSM parameter prefix")
+	format := fs.String("format", "dotenv", "Output format: json, yaml, or dotenv")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *prefix == "" {
+		return fmt.Errorf("--prefix is required")
+	}
+
+	params, err := fetchParameters(svc, ensureTrailingSlash(*prefix), false)
+	if err != nil {
+		return fmt.Errorf("failed to fetch parameters: %v", err)
+	}
+
+	kv := make(map[string]string, len(params))
+	for _, p := range params {
+		name := strings.Split(*p.Name, "/")
+		kv[name[len(name)-1]] = *p.Value
+	}
+
+	return writeKeyValues(os.Stdout, kv, *format)
+}
+
+func ensureTrailingSlash(prefix string) string {
+	if !strings.HasSuffix(prefix, "/") {
+		return prefix + "/"
+	}
+	return prefix
+}
+
+func resolveFormat(format, file string) string {
+	if format != "" {
+		return format
+	}
+	switch {
+	case strings.HasSuffix(file, ".json"):
+		return "json"
+	case strings.HasSuffix(file, ".yaml"), strings.HasSuffix(file, ".yml"):
+		return "yaml"
+	default:
+		return "dotenv"
+	}
+}
+
+// pushEntry is a key's value plus an optional per-key SecureString override.
+// Secure is nil when unspecified, in which case runPush falls back to --secure.
+type pushEntry struct {
+	Value  string
+	Secure *bool
+}
+
+// loadKeyValues reads path in the given format. JSON/YAML entries may be a
+// plain string, or an object ({"value": "...", "secure": true}) to override
+// --secure for that one key.
+func loadKeyValues(path, format string) (map[string]pushEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case "json":
+		var raw map[string]interface{}
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, err
+		}
+		return parsePushEntries(raw)
+	case "yaml":
+		var raw map[string]interface{}
+		if err := yaml.Unmarshal(data, &raw); err != nil {
+			return nil, err
+		}
+		return parsePushEntries(raw)
+	case "dotenv":
+		return parseDotenv(data), nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+// parsePushEntries converts decoded JSON/YAML values into pushEntry values.
+func parsePushEntries(raw map[string]interface{}) (map[string]pushEntry, error) {
+	kv := make(map[string]pushEntry, len(raw))
+	for key, v := range raw {
+		switch val := v.(type) {
+		case string:
+			kv[key] = pushEntry{Value: val}
+		case map[string]interface{}:
+			value, ok := val["value"].(string)
+			if !ok {
+				return nil, fmt.Errorf("%s: object entries must set a string \"value\"", key)
+			}
+			entry := pushEntry{Value: value}
+			if secure, ok := val["secure"].(bool); ok {
+				entry.Secure = &secure
+			}
+			kv[key] = entry
+		default:
+			return nil, fmt.Errorf("%s: unsupported value type %T", key, v)
+		}
+	}
+	return kv, nil
+}
+
+func parseDotenv(data []byte) map[string]pushEntry {
+	kv := make(map[string]pushEntry)
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		key := strings.TrimSpace(parts[0])
+		value := strings.Trim(strings.TrimSpace(parts[1]), `"'`)
+		kv[key] = pushEntry{Value: value}
+	}
+	return kv
+}
+
+func writeKeyValues(w io.Writer, kv map[string]string, format string) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(kv)
+	case "yaml":
+		enc := yaml.NewEncoder(w)
+		defer enc.Close()
+		return enc.Encode(kv)
+	case "dotenv":
+		keys := make([]string, 0, len(kv))
+		for k := range kv {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(w, "%s=%s\n", k, kv[k])
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported format %q", format)
+	}
+}
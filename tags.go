@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/manifoldco/promptui"
+)
+
+func listTags(svc *ssm.SSM, name string) ([]*ssm.Tag, error) {
+	result, err := svc.ListTagsForResource(&ssm.ListTagsForResourceInput{
+		ResourceType: aws.String("Parameter"),
+		ResourceId:   aws.String(name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.TagList, nil
+}
+
+// manageTags loops letting the user add and remove tags until they choose "Done".
+func manageTags(svc *ssm.SSM, name string) error {
+	for {
+		tags, err := listTags(svc, name)
+		if err != nil {
+			return fmt.Errorf("failed to list tags: %v", err)
+		}
+
+		items := []string{"Add tag"}
+		for _, tag := range tags {
+			items = append(items, fmt.Sprintf("Remove %s=%s", *tag.Key, *tag.Value))
+		}
+		items = append(items, "Done")
+
+		prompt := promptui.Select{
+			Label: fmt.Sprintf("Tags for %s", name),
+			Items: items,
+		}
+
+		index, _, err := prompt.Run()
+		if err != nil {
+			if err == promptui.ErrInterrupt {
+				return nil
+			}
+			return fmt.Errorf("prompt failed: %v", err)
+		}
+
+		if index == len(items)-1 {
+			return nil
+		}
+
+		if index == 0 {
+			keyPrompt := promptui.Prompt{Label: "Enter tag key"}
+			key, err := keyPrompt.Run()
+			if err != nil {
+				return fmt.Errorf("tag key prompt failed: %v", err)
+			}
+
+			valuePrompt := promptui.Prompt{Label: "Enter tag value"}
+			value, err := valuePrompt.Run()
+			if err != nil {
+				return fmt.Errorf("tag value prompt failed: %v", err)
+			}
+
+			_, err = svc.AddTagsToResource(&ssm.AddTagsToResourceInput{
+				ResourceType: aws.String("Parameter"),
+				ResourceId:   aws.String(name),
+				Tags:         []*ssm.Tag{{Key: aws.String(key), Value: aws.String(value)}},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to add tag: %v", err)
+			}
+			continue
+		}
+
+		tag := tags[index-1]
+		_, err = svc.RemoveTagsFromResource(&ssm.RemoveTagsFromResourceInput{
+			ResourceType: aws.String("Parameter"),
+			ResourceId:   aws.String(name),
+			TagKeys:      []*string{tag.Key},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to remove tag %s: %v", *tag.Key, err)
+		}
+	}
+}
+
+// promptForInitialTags reads a comma-separated Key=Value,Key2=Value2 list.
+func promptForInitialTags() ([]*ssm.Tag, error) {
+	prompt := promptui.Prompt{
+		Label: "Enter initial tags as Key=Value,Key2=Value2 (blank for none)",
+	}
+
+	input, err := prompt.Run()
+	if err != nil {
+		return nil, err
+	}
+
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return nil, nil
+	}
+
+	var tags []*ssm.Tag
+	for _, pair := range strings.Split(input, ",") {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		tags = append(tags, &ssm.Tag{
+			Key:   aws.String(strings.TrimSpace(parts[0])),
+			Value: aws.String(strings.TrimSpace(parts[1])),
+		})
+	}
+
+	return tags, nil
+}
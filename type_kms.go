@@ -0,0 +1,151 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/manifoldco/promptui"
+)
+
+// changeParameterSettings lets a user change a parameter's Type, KMS key, Tier and Description.
+func changeParameterSettings(svc *ssm.SSM, param *ssm.Parameter) (*ssm.Parameter, error) {
+	typePrompt := promptui.Select{
+		Label: "Select new parameter type",
+		Items: []string{"String", "StringList", "SecureString"},
+	}
+	_, newType, err := typePrompt.Run()
+	if err != nil {
+		return nil, fmt.Errorf("type prompt failed: %v", err)
+	}
+
+	var keyID *string
+	if newType == "SecureString" {
+		keyPrompt := promptui.Prompt{
+			Label: "Enter KMS KeyId (blank for the default alias/aws/ssm key)",
+		}
+		key, err := keyPrompt.Run()
+		if err != nil {
+			return nil, fmt.Errorf("KMS key prompt failed: %v", err)
+		}
+		if key != "" {
+			keyID = aws.String(key)
+		}
+	}
+
+	tierPrompt := promptui.Select{
+		Label: "Select parameter tier",
+		Items: []string{"Standard", "Advanced", "Intelligent-Tiering"},
+	}
+	_, tier, err := tierPrompt.Run()
+	if err != nil {
+		return nil, fmt.Errorf("tier prompt failed: %v", err)
+	}
+
+	descPrompt := promptui.Prompt{
+		Label: "Enter description (blank to leave unchanged)",
+	}
+	description, err := descPrompt.Run()
+	if err != nil {
+		return nil, fmt.Errorf("description prompt failed: %v", err)
+	}
+
+	input := &ssm.PutParameterInput{
+		Name:      param.Name,
+		Value:     param.Value,
+		Type:      aws.String(newType),
+		Overwrite: aws.Bool(true),
+		Tier:      aws.String(tier),
+	}
+	if keyID != nil {
+		input.KeyId = keyID
+	}
+	if description != "" {
+		input.Description = aws.String(description)
+	}
+
+	if _, err := svc.PutParameter(input); err != nil {
+		return nil, fmt.Errorf("failed to update parameter: %v", err)
+	}
+
+	return &ssm.Parameter{
+		Name:  param.Name,
+		Value: param.Value,
+		Type:  aws.String(newType),
+	}, nil
+}
+
+// fetchParameterMetadata looks up the Tier and KeyId for every parameter
+// under prefix via DescribeParameters, since GetParametersByPath doesn't return them.
+func fetchParameterMetadata(svc *ssm.SSM, prefix string) (map[string]*ssm.ParameterMetadata, error) {
+	metadata := make(map[string]*ssm.ParameterMetadata)
+	var nextToken *string
+
+	for {
+		result, err := svc.DescribeParameters(&ssm.DescribeParametersInput{
+			ParameterFilters: []*ssm.ParameterStringFilter{
+				{
+					Key:    aws.String("Path"),
+					Option: aws.String("Recursive"),
+					Values: []*string{aws.String(strings.TrimSuffix(prefix, "/"))},
+				},
+			},
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, m := range result.Parameters {
+			metadata[*m.Name] = m
+		}
+
+		if result.NextToken == nil {
+			break
+		}
+		nextToken = result.NextToken
+	}
+
+	return metadata, nil
+}
+
+// fetchSingleParameterMetadata looks up the Tier and KeyId for one parameter by name.
+func fetchSingleParameterMetadata(svc *ssm.SSM, name string) (*ssm.ParameterMetadata, error) {
+	result, err := svc.DescribeParameters(&ssm.DescribeParametersInput{
+		ParameterFilters: []*ssm.ParameterStringFilter{
+			{
+				Key:    aws.String("Name"),
+				Option: aws.String("Equals"),
+				Values: []*string{aws.String(name)},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Parameters) == 0 {
+		return nil, nil
+	}
+	return result.Parameters[0], nil
+}
+
+// formatParameterMetaSuffix renders the tier and KMS key of a parameter for
+// appending to its --debug listing line.
+func formatParameterMetaSuffix(meta *ssm.ParameterMetadata) string {
+	if meta == nil {
+		return ""
+	}
+
+	tier := "Standard"
+	if meta.Tier != nil {
+		tier = *meta.Tier
+	}
+
+	kms := "-"
+	if meta.KeyId != nil {
+		kms = *meta.KeyId
+	}
+
+	return fmt.Sprintf(" (tier=%s, kms=%s)", tier, kms)
+}